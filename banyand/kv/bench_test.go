@@ -0,0 +1,283 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3/skl"
+	"github.com/dgraph-io/badger/v3/y"
+
+	"github.com/apache/skywalking-banyandb/pkg/convert"
+	"github.com/apache/skywalking-banyandb/pkg/logger"
+	"github.com/apache/skywalking-banyandb/pkg/timestamp"
+)
+
+const benchKeyCount = 1000
+
+type benchCase struct {
+	keySize     int
+	valueSize   int
+	shardNum    int
+	parallelism int
+}
+
+func (c benchCase) String() string {
+	return fmt.Sprintf("key_%d/value_%d/shard_%d/goroutine_%d", c.keySize, c.valueSize, c.shardNum, c.parallelism)
+}
+
+var benchCases = []benchCase{
+	{keySize: 16, valueSize: 64, shardNum: 1, parallelism: 1},
+	{keySize: 16, valueSize: 1024, shardNum: 1, parallelism: 1},
+	{keySize: 64, valueSize: 64, shardNum: 1, parallelism: 1},
+	{keySize: 16, valueSize: 64, shardNum: 4, parallelism: 1},
+	{keySize: 16, valueSize: 64, shardNum: 4, parallelism: 8},
+	{keySize: 16, valueSize: 1024, shardNum: 8, parallelism: 16},
+}
+
+func BenchmarkStorePut(b *testing.B) {
+	for _, bc := range benchCases {
+		b.Run(bc.String(), func(b *testing.B) {
+			stores := openBenchStores(b, bc.shardNum)
+			keys := newBenchKeys(bc.keySize, benchKeyCount)
+			value := make([]byte, bc.valueSize)
+			b.ReportAllocs()
+			b.ResetTimer()
+			runConcurrently(b, bc.parallelism, func(i int) {
+				key := keys[i%len(keys)]
+				if err := stores[shardOf(key, bc.shardNum)].Put(key, value); err != nil {
+					b.Error(err)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkStoreGet(b *testing.B) {
+	for _, bc := range benchCases {
+		b.Run(bc.String(), func(b *testing.B) {
+			stores := openBenchStores(b, bc.shardNum)
+			keys := newBenchKeys(bc.keySize, benchKeyCount)
+			fillBenchStores(b, stores, keys, bc.valueSize)
+			b.ReportAllocs()
+			b.ResetTimer()
+			runConcurrently(b, bc.parallelism, func(i int) {
+				key := keys[i%len(keys)]
+				if _, err := stores[shardOf(key, bc.shardNum)].Get(key); err != nil {
+					b.Error(err)
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkStoreScan scans every shard in each op, so an op covers the whole key set regardless of shard count.
+func BenchmarkStoreScan(b *testing.B) {
+	for _, bc := range benchCases {
+		b.Run(bc.String(), func(b *testing.B) {
+			stores := openBenchStores(b, bc.shardNum)
+			keys := newBenchKeys(bc.keySize, benchKeyCount)
+			fillBenchStores(b, stores, keys, bc.valueSize)
+			opts := DefaultScanOpts
+			// The all-zero key sorts before every bench key, so the scan starts at the first pair.
+			seekKey := make([]byte, bc.keySize)
+			b.ReportAllocs()
+			b.ResetTimer()
+			runConcurrently(b, bc.parallelism, func(_ int) {
+				for _, s := range stores {
+					err := s.Scan(nil, seekKey, opts, func(_ []byte, getVal func() ([]byte, error)) error {
+						_, errVal := getVal()
+						return errVal
+					})
+					if err != nil {
+						b.Error(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+// BenchmarkTimeSeriesStoreHandover hands the whole key set over to the shards in each op.
+// TimeSeriesStore has no Put, so handing over a skiplist is its only write path.
+// Skiplists are built with the timer stopped, one round of concurrent ops at a time.
+func BenchmarkTimeSeriesStoreHandover(b *testing.B) {
+	for _, bc := range benchCases {
+		b.Run(bc.String(), func(b *testing.B) {
+			stores := openBenchTimeSeriesStores(b, bc.shardNum)
+			shardKeys := splitByShard(newBenchKeys(bc.keySize, benchKeyCount), bc.shardNum)
+			value := make([]byte, bc.valueSize)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for done := 0; done < b.N; done += bc.parallelism {
+				round := bc.parallelism
+				if b.N-done < round {
+					round = b.N - done
+				}
+				b.StopTimer()
+				lists := make([][]*skl.Skiplist, round)
+				for g := range lists {
+					lists[g] = make([]*skl.Skiplist, bc.shardNum)
+					for shard, keys := range shardKeys {
+						lists[g][shard] = newBenchSkiplist(keys, value, uint64(done+g+1))
+					}
+				}
+				b.StartTimer()
+				var wg sync.WaitGroup
+				wg.Add(round)
+				for g := 0; g < round; g++ {
+					go func(g int) {
+						defer wg.Done()
+						for shard, sl := range lists[g] {
+							if err := stores[shard].Handover(sl); err != nil {
+								b.Error(err)
+							}
+						}
+					}(g)
+				}
+				wg.Wait()
+			}
+		})
+	}
+}
+
+func BenchmarkTimeSeriesReaderGet(b *testing.B) {
+	for _, bc := range benchCases {
+		b.Run(bc.String(), func(b *testing.B) {
+			stores := openBenchTimeSeriesStores(b, bc.shardNum)
+			keys := newBenchKeys(bc.keySize, benchKeyCount)
+			value := make([]byte, bc.valueSize)
+			const ts = 1
+			for shard, shardKeys := range splitByShard(keys, bc.shardNum) {
+				if err := stores[shard].Handover(newBenchSkiplist(shardKeys, value, ts)); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			runConcurrently(b, bc.parallelism, func(i int) {
+				key := keys[i%len(keys)]
+				if _, err := stores[shardOf(key, bc.shardNum)].Get(key, ts); err != nil {
+					b.Error(err)
+				}
+			})
+		})
+	}
+}
+
+// runConcurrently spreads b.N invocations of fn over the given number of goroutines.
+func runConcurrently(b *testing.B, parallelism int, fn func(i int)) {
+	var wg sync.WaitGroup
+	wg.Add(parallelism)
+	for g := 0; g < parallelism; g++ {
+		go func(g int) {
+			defer wg.Done()
+			for i := g; i < b.N; i += parallelism {
+				fn(i)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func shardOf(key []byte, shardNum int) int {
+	return int(convert.Hash(key) % uint64(shardNum))
+}
+
+func splitByShard(keys [][]byte, shardNum int) [][][]byte {
+	shards := make([][][]byte, shardNum)
+	for _, k := range keys {
+		shard := shardOf(k, shardNum)
+		shards[shard] = append(shards[shard], k)
+	}
+	return shards
+}
+
+func newBenchKeys(size, count int) [][]byte {
+	keys := make([][]byte, count)
+	for i := range keys {
+		k := make([]byte, size)
+		binary.BigEndian.PutUint64(k[size-8:], uint64(i))
+		keys[i] = k
+	}
+	return keys
+}
+
+func newBenchSkiplist(keys [][]byte, value []byte, ts uint64) *skl.Skiplist {
+	size := int64(skl.MaxNodeSize)
+	for _, k := range keys {
+		size += int64(skl.MaxNodeSize + len(k) + 8 + len(value) + 8)
+	}
+	sl := skl.NewSkiplist(size)
+	for _, k := range keys {
+		sl.Put(y.KeyWithTs(k, ts), y.ValueStruct{Value: value})
+	}
+	return sl
+}
+
+// newBenchLogger keeps badger's info logs out of the benchmark output.
+func newBenchLogger(b *testing.B) *logger.Logger {
+	if err := logger.Init(logger.Logging{Env: "dev", Level: "warn"}); err != nil {
+		b.Fatal(err)
+	}
+	return logger.GetLogger("kv-bench")
+}
+
+func openBenchStores(b *testing.B, shardNum int) []Store {
+	l := newBenchLogger(b)
+	stores := make([]Store, shardNum)
+	for i := range stores {
+		s, err := OpenStore(filepath.Join(b.TempDir(), fmt.Sprintf("shard-%d", i)), StoreWithLogger(l))
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Cleanup(func() { _ = s.Close() })
+		stores[i] = s
+	}
+	return stores
+}
+
+func fillBenchStores(b *testing.B, stores []Store, keys [][]byte, valueSize int) {
+	value := make([]byte, valueSize)
+	for _, k := range keys {
+		if err := stores[shardOf(k, len(stores))].Put(k, value); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func openBenchTimeSeriesStores(b *testing.B, shardNum int) []TimeSeriesStore {
+	tr := timestamp.NewInclusiveTimeRange(
+		timestamp.DefaultTimeRange.Begin.AsTime(),
+		timestamp.DefaultTimeRange.End.AsTime())
+	l := newBenchLogger(b)
+	stores := make([]TimeSeriesStore, shardNum)
+	for i := range stores {
+		s, err := OpenTimeSeriesStore(filepath.Join(b.TempDir(), fmt.Sprintf("shard-%d", i)), tr, TSSWithLogger(l))
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Cleanup(func() { _ = s.Close() })
+		stores[i] = s
+	}
+	return stores
+}
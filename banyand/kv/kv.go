@@ -56,7 +56,9 @@ type ScanFunc func(key []byte, getVal func() ([]byte, error)) error
 
 // ScanOpts wraps options for scanning the kv storage.
 type ScanOpts struct {
-	Prefix         []byte
+	Prefix []byte
+	// PrefetchSize is a hint of how many values the iterator reads ahead.
+	// It only takes effect when PrefetchValues is true, and it does not limit the number of scanned pairs.
 	PrefetchSize   int
 	PrefetchValues bool
 	Reverse        bool
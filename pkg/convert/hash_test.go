@@ -64,3 +64,25 @@ func TestHashStr(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkHash(b *testing.B) {
+	key := []byte("service_instance_cpm_minute")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkUint64 = Hash(key)
+		}
+	}
+	reportPerConversion(b)
+}
+
+func BenchmarkHashStr(b *testing.B) {
+	key := "service_instance_cpm_minute"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkUint64 = HashStr(key)
+		}
+	}
+	reportPerConversion(b)
+}
@@ -22,6 +22,18 @@ import (
 	"testing"
 )
 
+// opsPerIteration is the number of conversions run in a single b.N iteration.
+// It amortizes the loop overhead so that ns/conv reflects the conversion itself.
+const opsPerIteration = 1_000_000
+
+var (
+	sinkBytes  []byte
+	sinkUint64 uint64
+	sinkUint32 uint32
+	sinkInt64  int64
+	sinkFloat  float64
+)
+
 // TODO: fix this case.
 func TestInt64ToBytes(_ *testing.T) {
 	fmt.Println(Int64ToBytes(-100))
@@ -32,3 +44,93 @@ func TestInt64ToBytes(_ *testing.T) {
 	fmt.Println(Int64ToBytes(2))
 	fmt.Println(Int64ToBytes(100))
 }
+
+func BenchmarkUint64ToBytes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkBytes = Uint64ToBytes(uint64(j))
+		}
+	}
+	reportPerConversion(b)
+}
+
+func BenchmarkBytesToUint64(b *testing.B) {
+	bs := Uint64ToBytes(1 << 40)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkUint64 = BytesToUint64(bs)
+		}
+	}
+	reportPerConversion(b)
+}
+
+func BenchmarkInt64ToBytes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkBytes = Int64ToBytes(int64(j - opsPerIteration/2))
+		}
+	}
+	reportPerConversion(b)
+}
+
+func BenchmarkBytesToInt64(b *testing.B) {
+	bs := Int64ToBytes(-100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkInt64 = BytesToInt64(bs)
+		}
+	}
+	reportPerConversion(b)
+}
+
+func BenchmarkUint32ToBytes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkBytes = Uint32ToBytes(uint32(j))
+		}
+	}
+	reportPerConversion(b)
+}
+
+func BenchmarkBytesToUint32(b *testing.B) {
+	bs := Uint32ToBytes(1 << 20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkUint32 = BytesToUint32(bs)
+		}
+	}
+	reportPerConversion(b)
+}
+
+func BenchmarkFloat64ToBytes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkBytes = Float64ToBytes(float64(j) / 3)
+		}
+	}
+	reportPerConversion(b)
+}
+
+func BenchmarkBytesToFloat64(b *testing.B) {
+	bs := Float64ToBytes(3.14)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < opsPerIteration; j++ {
+			sinkFloat = BytesToFloat64(bs)
+		}
+	}
+	reportPerConversion(b)
+}
+
+// reportPerConversion reports the average cost of one conversion,
+// since ns/op covers opsPerIteration conversions.
+func reportPerConversion(b *testing.B) {
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N)/opsPerIteration, "ns/conv")
+}
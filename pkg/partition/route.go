@@ -18,6 +18,8 @@
 package partition
 
 import (
+	"math"
+
 	"github.com/pkg/errors"
 
 	"github.com/apache/skywalking-banyandb/pkg/convert"
@@ -31,3 +33,25 @@ func ShardID(key []byte, shardNum uint32) (uint, error) {
 	encodeKey := convert.Hash(key)
 	return uint(encodeKey % uint64(shardNum)), nil
 }
+
+// JumpShardID calculates a shard id with Jump Consistent Hash (Lamping & Veach, 2014).
+// Unlike ShardID, growing shardNum from n to n+1 only moves about 1/(n+1) of the keys,
+// and every moved key lands in the new shard. It returns 0 if shardNum is less than 2.
+// Like the original algorithm, it supports at most math.MaxInt32 shards; a larger shardNum
+// is treated as math.MaxInt32.
+func JumpShardID(key []byte, shardNum uint) uint {
+	if shardNum > math.MaxInt32 {
+		shardNum = math.MaxInt32
+	}
+	h := convert.Hash(key)
+	var b, j int64 = -1, 0
+	for j < int64(shardNum) {
+		b = j
+		h = h*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((h>>33)+1)))
+	}
+	if b < 0 {
+		return 0
+	}
+	return uint(b)
+}
@@ -0,0 +1,106 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package partition
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestJumpShardID(t *testing.T) {
+	tests := []struct {
+		key      string
+		shardNum uint
+		want     uint
+	}{
+		{"", 0, 0},
+		{"", 1, 0},
+		{"", 2, 1},
+		{"", 8, 7},
+		{"", 16, 7},
+		{"", 1024, 332},
+		{"hello", 0, 0},
+		{"hello", 1, 0},
+		{"hello", 8, 5},
+		{"hello", 16, 5},
+		{"hello", 1024, 309},
+		{"service_1", 2, 0},
+		{"service_1", 8, 7},
+		{"service_1", 16, 12},
+		{"trace-0001", 8, 0},
+		{"trace-0001", 16, 12},
+		{"trace-0001", 1024, 191},
+		{"hello", math.MaxInt32, 2074235668},
+		{"hello", math.MaxUint, 2074235668},
+		{"trace-0001", math.MaxInt32, 960316475},
+		{"trace-0001", math.MaxUint, 960316475},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tt.key, tt.shardNum), func(t *testing.T) {
+			if got := JumpShardID([]byte(tt.key), tt.shardNum); got != tt.want {
+				t.Errorf("JumpShardID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJumpShardIDRemapping(t *testing.T) {
+	const keyNum = 10000
+	keys := make([][]byte, keyNum)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key-%d", i))
+	}
+	for _, shardNum := range []uint{1, 2, 4, 7, 16, 31} {
+		t.Run(fmt.Sprintf("%d_to_%d", shardNum, shardNum+1), func(t *testing.T) {
+			var jumpMoved, modMoved int
+			for _, k := range keys {
+				before, after := JumpShardID(k, shardNum), JumpShardID(k, shardNum+1)
+				if after >= shardNum+1 {
+					t.Fatalf("shard %d is out of range [0, %d)", after, shardNum+1)
+				}
+				if before != after {
+					jumpMoved++
+					if after != shardNum {
+						t.Fatalf("key %s moved from shard %d to an existing shard %d", k, before, after)
+					}
+				}
+				modBefore, err := ShardID(k, uint32(shardNum))
+				if err != nil {
+					t.Fatal(err)
+				}
+				modAfter, err := ShardID(k, uint32(shardNum+1))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if modBefore != modAfter {
+					modMoved++
+				}
+			}
+			jumpRatio := float64(jumpMoved) / keyNum
+			modRatio := float64(modMoved) / keyNum
+			ideal := 1 / float64(shardNum+1)
+			if jumpRatio > ideal*1.2 {
+				t.Errorf("jump hash moved %.3f of keys, want about %.3f", jumpRatio, ideal)
+			}
+			if shardNum > 1 && modRatio <= jumpRatio {
+				t.Errorf("modulo moved %.3f of keys, expected more than jump hash's %.3f", modRatio, jumpRatio)
+			}
+		})
+	}
+}